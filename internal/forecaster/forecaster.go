@@ -0,0 +1,223 @@
+package forecaster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+	"github.com/anthonygilbertt/go-weather-app/internal/provider/nws"
+	"github.com/anthonygilbertt/go-weather-app/internal/provider/openweather"
+)
+
+// ErrNoForecastForPoint is returned when a provider has no forecast periods
+// for an otherwise-valid point.
+var ErrNoForecastForPoint = errors.New("no forecast periods returned for point")
+
+// defaultProviderName is used when a request doesn't specify provider= and
+// WEATHER_PROVIDER isn't set.
+const defaultProviderName = "nws"
+
+// httpClient is shared by the geocoders and every provider so upstream
+// calls consistently time out instead of hanging a request forever.
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+var geocoder Geocoder = newCompositeGeocoder()
+
+// Forecaster resolves locations and fetches forecasts, delegating to
+// whichever provider.Provider the caller selects. It is safe for
+// concurrent use and is shared by the HTTP handlers and the gRPC server.
+type Forecaster struct {
+	providers       map[string]provider.Provider
+	defaultProvider string
+	nws             *nws.Client // hourly/alerts have no cross-provider equivalent
+}
+
+// New returns a ready-to-use Forecaster. The default provider is read from
+// the WEATHER_PROVIDER environment variable, falling back to "nws".
+func New() *Forecaster {
+	nwsClient := nws.New(httpClient)
+	owClient := openweather.New(os.Getenv("OPENWEATHER_API_KEY"), httpClient)
+
+	defaultProvider := os.Getenv("WEATHER_PROVIDER")
+	if defaultProvider == "" {
+		defaultProvider = defaultProviderName
+	}
+
+	return &Forecaster{
+		providers: map[string]provider.Provider{
+			"nws":         nwsClient,
+			"openweather": owClient,
+		},
+		defaultProvider: defaultProvider,
+		nws:             nwsClient,
+	}
+}
+
+// ResolveLocation turns a city name or ZIP code into a LatLon. Coordinate
+// locations don't need resolution and are constructed directly by callers.
+func (f *Forecaster) ResolveLocation(ctx context.Context, query string, locType LocationType) (LatLon, error) {
+	return geocoder.Geocode(ctx, query, locType)
+}
+
+// Get fetches today's short forecast and temperature classification for loc
+// in the requested units, from the named provider ("" selects the default).
+func (f *Forecaster) Get(ctx context.Context, loc LatLon, units provider.Units, providerName string) (Result, error) {
+	p, err := f.provider(providerName)
+	if err != nil {
+		return Result{}, err
+	}
+
+	fc, err := p.Fetch(ctx, loc.Lat, loc.Lon, units, 1)
+	if err != nil {
+		return Result{}, err
+	}
+
+	today := time.Now().Format("2006-01-02")
+	for _, per := range fc.Periods {
+		if per.IsDaytime && len(per.StartTime) >= 10 && per.StartTime[:10] == today {
+			return Result{ShortForecast: per.ShortForecast, Temperature: per.Temperature, Classification: classify(per.Temperature, units)}, nil
+		}
+	}
+
+	if len(fc.Periods) == 0 {
+		return Result{}, ErrNoForecastForPoint
+	}
+
+	// Fallback to first period
+	per := fc.Periods[0]
+	return Result{ShortForecast: per.ShortForecast, Temperature: per.Temperature, Classification: classify(per.Temperature, units)}, nil
+}
+
+// GetExtended fetches up to days worth of forecast periods for loc in the
+// requested units, from the named provider. A days of 0 or less returns
+// every period the provider returns by default.
+func (f *Forecaster) GetExtended(ctx context.Context, loc LatLon, units provider.Units, days int, providerName string) ([]ForecastPeriod, error) {
+	p, err := f.provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	fc, err := p.Fetch(ctx, loc.Lat, loc.Lon, units, days)
+	if err != nil {
+		return nil, err
+	}
+	return fc.Periods, nil
+}
+
+// GetHourly fetches the hourly forecast periods for loc in the requested
+// units. Hourly grids are an NWS-specific feature, so this always uses the
+// nws provider regardless of providerName selection elsewhere.
+func (f *Forecaster) GetHourly(ctx context.Context, loc LatLon, units provider.Units) ([]ForecastPeriod, error) {
+	fc, err := f.nws.Hourly(ctx, loc.Lat, loc.Lon, units)
+	if err != nil {
+		if errors.Is(err, nws.ErrNoForecastForPoint) {
+			return nil, ErrNoForecastForPoint
+		}
+		return nil, err
+	}
+	return fc.Periods, nil
+}
+
+// GetAlerts fetches the active NWS alerts covering loc.
+func (f *Forecaster) GetAlerts(ctx context.Context, loc LatLon) (AlertFeatureCollection, error) {
+	return f.nws.Alerts(ctx, loc.Lat, loc.Lon)
+}
+
+// GetBundle fans out Get, GetHourly, GetExtended, and GetAlerts concurrently
+// and combines them into a single Bundle. It returns the first error
+// encountered, if any.
+func (f *Forecaster) GetBundle(ctx context.Context, loc LatLon, units provider.Units, days int, providerName string) (Bundle, error) {
+	var (
+		wg               sync.WaitGroup
+		current          Result
+		hourly, extended []ForecastPeriod
+		alerts           AlertFeatureCollection
+		currentErr       error
+		hourlyErr        error
+		extendedErr      error
+		alertsErr        error
+	)
+
+	wg.Add(4)
+	go func() { defer wg.Done(); current, currentErr = f.Get(ctx, loc, units, providerName) }()
+	go func() { defer wg.Done(); hourly, hourlyErr = f.GetHourly(ctx, loc, units) }()
+	go func() { defer wg.Done(); extended, extendedErr = f.GetExtended(ctx, loc, units, days, providerName) }()
+	go func() { defer wg.Done(); alerts, alertsErr = f.GetAlerts(ctx, loc) }()
+	wg.Wait()
+
+	for _, err := range []error{currentErr, hourlyErr, extendedErr, alertsErr} {
+		if err != nil {
+			return Bundle{}, err
+		}
+	}
+
+	return Bundle{Current: current, Hourly: hourly, Extended: extended, Alerts: alerts}, nil
+}
+
+// CacheStats reports point-in-time hit/miss/stale counts for the nws
+// provider's points and forecast caches.
+func (f *Forecaster) CacheStats() map[string]map[string]int64 {
+	return f.nws.CacheStats()
+}
+
+func (f *Forecaster) provider(name string) (provider.Provider, error) {
+	if name == "" {
+		name = f.defaultProvider
+	}
+	p, ok := f.providers[name]
+	if !ok {
+		return nil, &UnknownProviderError{Name: name}
+	}
+	return p, nil
+}
+
+// UnknownProviderError indicates the caller requested a provider= name this
+// Forecaster wasn't configured with. It's a client input error, like a bad
+// location, rather than an upstream failure, so callers should surface it as
+// a 400/InvalidArgument instead of a 500/Internal.
+type UnknownProviderError struct {
+	Name string
+}
+
+func (e *UnknownProviderError) Error() string {
+	return fmt.Sprintf("unknown provider %q", e.Name)
+}
+
+// classify buckets a temperature into hot/cold/moderate. Thresholds are
+// unit-specific: 80/50°F, 27/10°C, and their Kelvin equivalents.
+func classify(temp int, units provider.Units) string {
+	switch units {
+	case provider.Metric:
+		switch {
+		case temp >= 27:
+			return "hot"
+		case temp <= 10:
+			return "cold"
+		default:
+			return "moderate"
+		}
+	case provider.Standard:
+		switch {
+		case temp >= 300: // ~27°C
+			return "hot"
+		case temp <= 283: // ~10°C
+			return "cold"
+		default:
+			return "moderate"
+		}
+	default: // imperial
+		switch {
+		case temp >= 80:
+			return "hot"
+		case temp <= 50:
+			return "cold"
+		default:
+			return "moderate"
+		}
+	}
+}
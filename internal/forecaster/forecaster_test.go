@@ -0,0 +1,64 @@
+package forecaster
+
+import (
+	"testing"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+)
+
+func TestClassifyImperial(t *testing.T) {
+	cases := []struct {
+		temp int
+		want string
+	}{
+		{79, "moderate"},
+		{80, "hot"},
+		{81, "hot"},
+		{51, "moderate"},
+		{50, "cold"},
+		{49, "cold"},
+	}
+	for _, c := range cases {
+		if got := classify(c.temp, provider.Imperial); got != c.want {
+			t.Errorf("classify(%d, imperial) = %q, want %q", c.temp, got, c.want)
+		}
+	}
+}
+
+func TestClassifyMetric(t *testing.T) {
+	cases := []struct {
+		temp int
+		want string
+	}{
+		{26, "moderate"},
+		{27, "hot"},
+		{28, "hot"},
+		{11, "moderate"},
+		{10, "cold"},
+		{9, "cold"},
+	}
+	for _, c := range cases {
+		if got := classify(c.temp, provider.Metric); got != c.want {
+			t.Errorf("classify(%d, metric) = %q, want %q", c.temp, got, c.want)
+		}
+	}
+}
+
+func TestClassifyStandard(t *testing.T) {
+	cases := []struct {
+		temp int
+		want string
+	}{
+		{299, "moderate"},
+		{300, "hot"},
+		{301, "hot"},
+		{284, "moderate"},
+		{283, "cold"},
+		{282, "cold"},
+	}
+	for _, c := range cases {
+		if got := classify(c.temp, provider.Standard); got != c.want {
+			t.Errorf("classify(%d, standard) = %q, want %q", c.temp, got, c.want)
+		}
+	}
+}
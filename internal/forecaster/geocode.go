@@ -0,0 +1,165 @@
+package forecaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// LocationType identifies how a request location was specified.
+type LocationType int
+
+const (
+	LocationCoords LocationType = iota
+	LocationCity
+	LocationZip
+)
+
+func (t LocationType) String() string {
+	switch t {
+	case LocationCity:
+		return "city"
+	case LocationZip:
+		return "zip"
+	default:
+		return "coords"
+	}
+}
+
+// LatLon is a resolved geographic coordinate.
+type LatLon struct {
+	Lat float64
+	Lon float64
+}
+
+// Geocoder resolves a free-form location string of the given type into
+// coordinates that the forecast providers can consume.
+type Geocoder interface {
+	Geocode(ctx context.Context, query string, locType LocationType) (LatLon, error)
+}
+
+// geocodeError is returned when a location string cannot be resolved. It is
+// surfaced to callers as a 400 rather than the generic 500 used for upstream
+// forecast failures.
+type geocodeError struct {
+	query string
+	cause error
+}
+
+func (e *geocodeError) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("could not resolve location %q: %v", e.query, e.cause)
+	}
+	return fmt.Sprintf("could not resolve location %q", e.query)
+}
+
+// compositeGeocoder dispatches to a zip-code geocoder or a city geocoder
+// depending on the LocationType requested.
+type compositeGeocoder struct {
+	zip  Geocoder
+	city Geocoder
+}
+
+func newCompositeGeocoder() *compositeGeocoder {
+	return &compositeGeocoder{
+		zip:  &censusGeocoder{},
+		city: newOpenWeatherGeocoder(os.Getenv("OPENWEATHER_API_KEY")),
+	}
+}
+
+func (c *compositeGeocoder) Geocode(ctx context.Context, query string, locType LocationType) (LatLon, error) {
+	switch locType {
+	case LocationZip:
+		return c.zip.Geocode(ctx, query, locType)
+	case LocationCity:
+		return c.city.Geocode(ctx, query, locType)
+	default:
+		return LatLon{}, &geocodeError{query: query, cause: fmt.Errorf("unsupported location type %s", locType)}
+	}
+}
+
+// censusGeocoder resolves US ZIP codes via the Census.gov geocoding API.
+type censusGeocoder struct{}
+
+func (c *censusGeocoder) Geocode(ctx context.Context, query string, locType LocationType) (LatLon, error) {
+	geocodeURL := fmt.Sprintf(
+		"https://geocoding.geo.census.gov/geocoder/locations/address?zip=%s&benchmark=Public_AR_Current&format=json",
+		url.QueryEscape(query),
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, geocodeURL, nil)
+	if err != nil {
+		return LatLon{}, &geocodeError{query: query, cause: err}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return LatLon{}, &geocodeError{query: query, cause: err}
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var cr struct {
+		Result struct {
+			AddressMatches []struct {
+				Coordinates struct {
+					X float64 `json:"x"`
+					Y float64 `json:"y"`
+				} `json:"coordinates"`
+			} `json:"addressMatches"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &cr); err != nil {
+		return LatLon{}, &geocodeError{query: query, cause: err}
+	}
+	if len(cr.Result.AddressMatches) == 0 {
+		return LatLon{}, &geocodeError{query: query}
+	}
+	match := cr.Result.AddressMatches[0]
+	return LatLon{Lat: match.Coordinates.Y, Lon: match.Coordinates.X}, nil
+}
+
+// openWeatherGeocoder resolves city names via the OpenWeather geocoding
+// endpoint. An API key is required; without one, city lookups fail closed.
+type openWeatherGeocoder struct {
+	apiKey string
+}
+
+func newOpenWeatherGeocoder(apiKey string) *openWeatherGeocoder {
+	return &openWeatherGeocoder{apiKey: apiKey}
+}
+
+func (g *openWeatherGeocoder) Geocode(ctx context.Context, query string, locType LocationType) (LatLon, error) {
+	if g.apiKey == "" {
+		return LatLon{}, &geocodeError{query: query, cause: fmt.Errorf("OPENWEATHER_API_KEY not configured")}
+	}
+	geocodeURL := fmt.Sprintf(
+		"https://api.openweathermap.org/geo/1.0/direct?q=%s&limit=1&appid=%s",
+		url.QueryEscape(strings.TrimSpace(query)), g.apiKey,
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, geocodeURL, nil)
+	if err != nil {
+		return LatLon{}, &geocodeError{query: query, cause: err}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return LatLon{}, &geocodeError{query: query, cause: err}
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var results []struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	}
+	if err := json.Unmarshal(body, &results); err != nil {
+		return LatLon{}, &geocodeError{query: query, cause: err}
+	}
+	if len(results) == 0 {
+		return LatLon{}, &geocodeError{query: query}
+	}
+	return LatLon{Lat: results[0].Lat, Lon: results[0].Lon}, nil
+}
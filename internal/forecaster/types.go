@@ -0,0 +1,35 @@
+// Package forecaster resolves locations and fetches forecasts from a
+// pluggable weather provider (see internal/provider). It is the shared core
+// consumed by both the HTTP handlers in main and the gRPC service in
+// internal/grpcserver.
+package forecaster
+
+import (
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+	"github.com/anthonygilbertt/go-weather-app/internal/provider/nws"
+)
+
+// ForecastPeriod is a single forecast period, independent of which provider
+// returned it.
+type ForecastPeriod = provider.Period
+
+// AlertFeatureCollection is the active-alerts response shape. Alerts are an
+// NWS-specific feature with no equivalent in other providers.
+type AlertFeatureCollection = nws.AlertFeatureCollection
+
+// Result is the current-conditions forecast outcome returned to callers,
+// independent of whether they arrived over HTTP or gRPC.
+type Result struct {
+	ShortForecast  string
+	Temperature    int
+	Classification string
+}
+
+// Bundle fans out current, hourly, extended, and alert data for a single
+// location into one payload.
+type Bundle struct {
+	Current  Result                 `json:"current"`
+	Hourly   []ForecastPeriod       `json:"hourly"`
+	Extended []ForecastPeriod       `json:"extended"`
+	Alerts   AlertFeatureCollection `json:"alerts"`
+}
@@ -0,0 +1,154 @@
+// Package grpcserver exposes a forecaster.Forecaster over the WeatherService
+// gRPC API defined in proto/weather.proto. The message and service types it
+// depends on (package weatherpb, imported here as pb) are generated by `make proto` and committed
+// alongside weather.proto; regenerate and commit the diff whenever the
+// proto file changes.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/forecaster"
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+	pb "github.com/anthonygilbertt/go-weather-app/proto"
+)
+
+// server adapts a forecaster.Forecaster to the generated
+// WeatherServiceServer interface.
+type server struct {
+	pb.UnimplementedWeatherServiceServer
+	fc *forecaster.Forecaster
+}
+
+// Register wires fc into s as the WeatherService implementation.
+func Register(s *grpc.Server, fc *forecaster.Forecaster) {
+	pb.RegisterWeatherServiceServer(s, &server{fc: fc})
+}
+
+func (s *server) GetCurrent(ctx context.Context, req *pb.ForecastRequest) (*pb.CurrentForecast, error) {
+	loc, err := resolveLocation(ctx, s.fc, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := provider.ParseUnits(req.GetUnits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.fc.Get(ctx, loc, units, req.GetProvider())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.CurrentForecast{
+		ShortForecast:  result.ShortForecast,
+		Temperature:    int32(result.Temperature),
+		Classification: result.Classification,
+	}, nil
+}
+
+func (s *server) GetHourly(ctx context.Context, req *pb.ForecastRequest) (*pb.HourlyForecast, error) {
+	loc, err := resolveLocation(ctx, s.fc, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := provider.ParseUnits(req.GetUnits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	periods, err := s.fc.GetHourly(ctx, loc, units)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.HourlyForecast{Periods: toPBPeriods(periods)}, nil
+}
+
+func (s *server) GetExtended(ctx context.Context, req *pb.ExtendedForecastRequest) (*pb.ExtendedForecast, error) {
+	loc, err := resolveLocation(ctx, s.fc, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := provider.ParseUnits(req.GetUnits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	periods, err := s.fc.GetExtended(ctx, loc, units, int(req.GetDays()), req.GetProvider())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ExtendedForecast{Periods: toPBPeriods(periods)}, nil
+}
+
+// toPBPeriods converts forecaster.ForecastPeriod values into their proto
+// equivalents.
+func toPBPeriods(periods []forecaster.ForecastPeriod) []*pb.ForecastPeriod {
+	out := make([]*pb.ForecastPeriod, 0, len(periods))
+	for _, p := range periods {
+		out = append(out, &pb.ForecastPeriod{
+			Name:             p.Name,
+			StartTime:        p.StartTime,
+			Temperature:      int32(p.Temperature),
+			TemperatureUnit:  p.TemperatureUnit,
+			WindSpeed:        p.WindSpeed,
+			WindDirection:    p.WindDirection,
+			ShortForecast:    p.ShortForecast,
+			DetailedForecast: p.DetailedForecast,
+			IsDaytime:        p.IsDaytime,
+		})
+	}
+	return out
+}
+
+// resolveLocation converts the proto oneof Location into a forecaster.LatLon,
+// geocoding city/zip values as needed.
+func resolveLocation(ctx context.Context, fc *forecaster.Forecaster, loc *pb.Location) (forecaster.LatLon, error) {
+	if loc == nil {
+		return forecaster.LatLon{}, status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	switch v := loc.GetValue().(type) {
+	case *pb.Location_Coordinates:
+		return forecaster.LatLon{Lat: v.Coordinates.GetLat(), Lon: v.Coordinates.GetLon()}, nil
+	case *pb.Location_City:
+		ll, err := fc.ResolveLocation(ctx, v.City, forecaster.LocationCity)
+		if err != nil {
+			return forecaster.LatLon{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return ll, nil
+	case *pb.Location_Zip:
+		ll, err := fc.ResolveLocation(ctx, v.Zip, forecaster.LocationZip)
+		if err != nil {
+			return forecaster.LatLon{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return ll, nil
+	default:
+		return forecaster.LatLon{}, status.Error(codes.InvalidArgument, "location must set coordinates, city, or zip")
+	}
+}
+
+// toStatus maps a Forecaster error to the gRPC status a client should act
+// on: a point with no forecast periods is NotFound, an unrecognized
+// provider= is InvalidArgument (a bad request, not an upstream failure), and
+// anything else (upstream HTTP failures, bad JSON) is Internal.
+func toStatus(err error) error {
+	if errors.Is(err, forecaster.ErrNoForecastForPoint) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	var upe *forecaster.UnknownProviderError
+	if errors.As(err, &upe) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
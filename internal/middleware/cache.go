@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"bytes"
+	"container/list"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	key       string
+	status    int
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+// ResponseCache is a bounded LRU cache of full HTTP responses, keyed by
+// path+query string, with an optional per-route TTL override.
+type ResponseCache struct {
+	mu         sync.Mutex
+	items      map[string]*list.Element
+	order      *list.List
+	capacity   int
+	defaultTTL time.Duration
+	routeTTL   map[string]time.Duration
+	metrics    *Metrics
+}
+
+// NewResponseCache builds a ResponseCache. routeTTL maps a request path
+// (e.g. "/weather/alerts") to a TTL that overrides defaultTTL.
+func NewResponseCache(capacity int, defaultTTL time.Duration, routeTTL map[string]time.Duration, metrics *Metrics) *ResponseCache {
+	return &ResponseCache{
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+		capacity:   capacity,
+		defaultTTL: defaultTTL,
+		routeTTL:   routeTTL,
+		metrics:    metrics,
+	}
+}
+
+// Middleware serves cached GET responses and caches new ones. Non-2xx/3xx
+// responses are not cached.
+func (c *ResponseCache) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key := r.URL.Path + "?" + r.URL.RawQuery
+		if entry, ok := c.get(key); ok {
+			c.metrics.incCacheHit()
+			header := w.Header()
+			for k, vs := range entry.header {
+				for _, v := range vs {
+					header.Add(k, v)
+				}
+			}
+			w.WriteHeader(entry.status)
+			w.Write(entry.body)
+			return
+		}
+		c.metrics.incCacheMiss()
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK, body: &bytes.Buffer{}}
+		next.ServeHTTP(rec, r)
+
+		if rec.status < 400 {
+			c.set(key, cacheEntry{
+				key:       key,
+				status:    rec.status,
+				header:    rec.Header().Clone(),
+				body:      rec.body.Bytes(),
+				expiresAt: time.Now().Add(c.ttlFor(r.URL.Path)),
+			})
+		}
+	})
+}
+
+func (c *ResponseCache) ttlFor(path string) time.Duration {
+	if ttl, ok := c.routeTTL[path]; ok {
+		return ttl
+	}
+	return c.defaultTTL
+}
+
+func (c *ResponseCache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		return cacheEntry{}, false
+	}
+	c.order.MoveToFront(el)
+	return *entry, true
+}
+
+func (c *ResponseCache) set(key string, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.order.MoveToFront(el)
+		*el.Value.(*cacheEntry) = entry
+		return
+	}
+
+	el := c.order.PushFront(&entry)
+	c.items[key] = el
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest == nil {
+			break
+		}
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// responseRecorder buffers a handler's response so it can be stored in the
+// cache while still being written through to the real client.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   *bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
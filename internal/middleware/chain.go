@@ -0,0 +1,34 @@
+// Package middleware provides the HTTP middleware chain wrapped around the
+// weather API: rate limiting, response caching, auth, and the metrics they
+// all report to.
+package middleware
+
+import "net/http"
+
+// Constructor adapts a handler into a wrapped handler. Chains compose left
+// to right, the same convention as github.com/justinas/alice.
+type Constructor func(http.Handler) http.Handler
+
+// Chain is an ordered list of Constructors.
+type Chain struct {
+	constructors []Constructor
+}
+
+// New builds a Chain from constructors, applied in the order given: the
+// first constructor sees the request first.
+func New(constructors ...Constructor) Chain {
+	return Chain{constructors: constructors}
+}
+
+// Then wraps h with the chain's constructors, outermost first.
+func (c Chain) Then(h http.Handler) http.Handler {
+	for i := len(c.constructors) - 1; i >= 0; i-- {
+		h = c.constructors[i](h)
+	}
+	return h
+}
+
+// ThenFunc is Then for a plain handler function.
+func (c Chain) ThenFunc(fn http.HandlerFunc) http.Handler {
+	return c.Then(fn)
+}
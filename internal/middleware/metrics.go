@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+)
+
+// Metrics holds the counters the middleware chain reports, exposed in
+// Prometheus text exposition format via Handler.
+type Metrics struct {
+	cacheHits    int64
+	cacheMisses  int64
+	throttled    int64
+	authFailures int64
+}
+
+// NewMetrics returns a zeroed Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+func (m *Metrics) incCacheHit()    { atomic.AddInt64(&m.cacheHits, 1) }
+func (m *Metrics) incCacheMiss()   { atomic.AddInt64(&m.cacheMisses, 1) }
+func (m *Metrics) incThrottled()   { atomic.AddInt64(&m.throttled, 1) }
+func (m *Metrics) incAuthFailure() { atomic.AddInt64(&m.authFailures, 1) }
+
+// Handler serves the counters in Prometheus text exposition format.
+func (m *Metrics) Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		writeCounter(w, "weather_cache_hits_total", "Response cache hits", atomic.LoadInt64(&m.cacheHits))
+		writeCounter(w, "weather_cache_misses_total", "Response cache misses", atomic.LoadInt64(&m.cacheMisses))
+		writeCounter(w, "weather_throttled_total", "Requests rejected by the rate limiter", atomic.LoadInt64(&m.throttled))
+		writeCounter(w, "weather_auth_failures_total", "Requests rejected by the auth middleware", atomic.LoadInt64(&m.authFailures))
+	}
+}
+
+func writeCounter(w http.ResponseWriter, name, help string, value int64) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", name)
+	fmt.Fprintf(w, "%s %d\n", name, value)
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"container/list"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultRateLimiterCapacity bounds how many distinct client keys a
+// RateLimiter tracks at once, evicting the least recently seen key once
+// exceeded so a flood of spoofed source IPs can't grow the limiter's
+// memory without bound.
+const defaultRateLimiterCapacity = 10000
+
+// RateLimiterConfig controls the steady-state rate and burst allowance of
+// the GCRA limiter.
+type RateLimiterConfig struct {
+	RPM      int // requests per minute at steady state
+	Burst    int // additional requests allowed in a burst
+	Capacity int // max distinct client keys tracked before LRU eviction; 0 uses a default
+}
+
+type tatEntry struct {
+	key string
+	tat time.Time
+}
+
+// RateLimiter is a per-client GCRA (generic cell rate algorithm) rate
+// limiter. GCRA needs only a single timestamp per key, so it stays cheap
+// even with many distinct IPs. Tracked keys are bounded by an LRU eviction
+// policy so the client set can't grow without bound.
+type RateLimiter struct {
+	mu       sync.Mutex
+	tat      map[string]*list.Element
+	order    *list.List
+	capacity int
+	emission time.Duration
+	burst    time.Duration
+	metrics  *Metrics
+}
+
+// NewRateLimiter builds a RateLimiter from cfg, reporting throttled requests
+// to metrics.
+func NewRateLimiter(cfg RateLimiterConfig, metrics *Metrics) *RateLimiter {
+	if cfg.RPM <= 0 {
+		cfg.RPM = 60
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = cfg.RPM
+	}
+	if cfg.Capacity <= 0 {
+		cfg.Capacity = defaultRateLimiterCapacity
+	}
+	emission := time.Minute / time.Duration(cfg.RPM)
+	return &RateLimiter{
+		tat:      make(map[string]*list.Element),
+		order:    list.New(),
+		capacity: cfg.Capacity,
+		emission: emission,
+		burst:    emission * time.Duration(cfg.Burst),
+		metrics:  metrics,
+	}
+}
+
+// Middleware rejects requests over the configured rate with a 429 and a
+// Retry-After header.
+func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed, retryAfter := rl.allow(clientIP(r))
+		if !allowed {
+			rl.metrics.incThrottled()
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allow implements GCRA: each key tracks its theoretical arrival time (TAT).
+// A request is allowed if admitting it would not push the TAT further into
+// the future than the configured burst tolerance.
+func (rl *RateLimiter) allow(key string) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	tat := now
+	if el, ok := rl.tat[key]; ok {
+		if cur := el.Value.(*tatEntry).tat; cur.After(now) {
+			tat = cur
+		}
+	}
+
+	newTAT := tat.Add(rl.emission)
+	allowAt := newTAT.Add(-(rl.emission + rl.burst))
+	if now.Before(allowAt) {
+		return false, allowAt.Sub(now)
+	}
+
+	rl.touch(key, newTAT)
+	return true, 0
+}
+
+// touch records key's new TAT and marks it most-recently-used, evicting the
+// least recently used key if this pushes the tracked set over capacity.
+func (rl *RateLimiter) touch(key string, tat time.Time) {
+	if el, ok := rl.tat[key]; ok {
+		el.Value.(*tatEntry).tat = tat
+		rl.order.MoveToFront(el)
+		return
+	}
+
+	el := rl.order.PushFront(&tatEntry{key: key, tat: tat})
+	rl.tat[key] = el
+
+	for rl.order.Len() > rl.capacity {
+		oldest := rl.order.Back()
+		if oldest == nil {
+			break
+		}
+		rl.order.Remove(oldest)
+		delete(rl.tat, oldest.Value.(*tatEntry).key)
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
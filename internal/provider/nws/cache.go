@@ -0,0 +1,100 @@
+package nws
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type cacheEntry[V any] struct {
+	key       string
+	value     V
+	expiresAt time.Time
+}
+
+// ttlCache is a bounded, concurrency-safe LRU cache with a per-entry TTL.
+// Expired entries are not evicted on access so callers can serve a stale
+// value when the upstream refresh fails (see get's fresh return value).
+type ttlCache[V any] struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+
+	hits, misses, staleServed int64
+}
+
+func newTTLCache[V any](ttl time.Duration, capacity int) *ttlCache[V] {
+	return &ttlCache[V]{
+		ttl:      ttl,
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// get reports whether key is present (ok) and, if so, whether it is still
+// within its TTL (fresh). A present-but-stale entry is still returned so the
+// caller can fall back to it if refreshing from upstream fails.
+func (c *ttlCache[V]) get(key string) (value V, fresh bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, found := c.items[key]
+	if !found {
+		c.misses++
+		return value, false, false
+	}
+	c.order.MoveToFront(el)
+	entry := el.Value.(*cacheEntry[V])
+	if time.Now().After(entry.expiresAt) {
+		c.misses++
+		return entry.value, false, true
+	}
+	c.hits++
+	return entry.value, true, true
+}
+
+func (c *ttlCache[V]) set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, found := c.items[key]; found {
+		c.order.MoveToFront(el)
+		el.Value.(*cacheEntry[V]).value = value
+		el.Value.(*cacheEntry[V]).expiresAt = time.Now().Add(c.ttl)
+		return
+	}
+
+	entry := &cacheEntry[V]{key: key, value: value, expiresAt: time.Now().Add(c.ttl)}
+	el := c.order.PushFront(entry)
+	c.items[key] = el
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest == nil {
+			break
+		}
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry[V]).key)
+	}
+}
+
+// stats returns a point-in-time snapshot of cache activity for diagnostics.
+func (c *ttlCache[V]) stats() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return map[string]int64{
+		"hits":        c.hits,
+		"misses":      c.misses,
+		"staleServed": c.staleServed,
+		"entries":     int64(c.order.Len()),
+	}
+}
+
+func (c *ttlCache[V]) markStaleServed() {
+	c.mu.Lock()
+	c.staleServed++
+	c.mu.Unlock()
+}
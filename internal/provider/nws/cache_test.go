@@ -0,0 +1,87 @@
+package nws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCacheMissOnEmpty(t *testing.T) {
+	c := newTTLCache[string](time.Minute, 2)
+
+	if _, fresh, ok := c.get("a"); ok || fresh {
+		t.Fatalf("get on empty cache = (fresh=%v, ok=%v), want (false, false)", fresh, ok)
+	}
+	stats := c.stats()
+	if stats["misses"] != 1 {
+		t.Errorf("misses = %d, want 1", stats["misses"])
+	}
+}
+
+func TestTTLCacheSetGet(t *testing.T) {
+	c := newTTLCache[string](time.Minute, 2)
+	c.set("a", "value-a")
+
+	value, fresh, ok := c.get("a")
+	if !ok || !fresh {
+		t.Fatalf("get after set = (value=%q, fresh=%v, ok=%v), want fresh hit", value, fresh, ok)
+	}
+	if value != "value-a" {
+		t.Errorf("value = %q, want %q", value, "value-a")
+	}
+	if stats := c.stats(); stats["hits"] != 1 {
+		t.Errorf("hits = %d, want 1", stats["hits"])
+	}
+}
+
+func TestTTLCacheExpiryServesStale(t *testing.T) {
+	c := newTTLCache[string](time.Millisecond, 2)
+	c.set("a", "value-a")
+	time.Sleep(5 * time.Millisecond)
+
+	value, fresh, ok := c.get("a")
+	if !ok {
+		t.Fatal("get after expiry = ok=false, want the stale entry to still be returned")
+	}
+	if fresh {
+		t.Fatal("get after expiry = fresh=true, want false")
+	}
+	if value != "value-a" {
+		t.Errorf("value = %q, want %q", value, "value-a")
+	}
+}
+
+func TestTTLCacheMarkStaleServed(t *testing.T) {
+	c := newTTLCache[string](time.Minute, 2)
+	c.markStaleServed()
+	c.markStaleServed()
+
+	if stats := c.stats(); stats["staleServed"] != 2 {
+		t.Errorf("staleServed = %d, want 2", stats["staleServed"])
+	}
+}
+
+func TestTTLCacheLRUEviction(t *testing.T) {
+	c := newTTLCache[string](time.Minute, 2)
+	c.set("a", "value-a")
+	c.set("b", "value-b")
+
+	// Touch "a" so it becomes most recently used, leaving "b" as the
+	// eviction candidate once a third key is added.
+	if _, _, ok := c.get("a"); !ok {
+		t.Fatal("expected a to be present before eviction")
+	}
+	c.set("c", "value-c")
+
+	if _, _, ok := c.get("b"); ok {
+		t.Error("b should have been evicted as the least recently used entry")
+	}
+	if _, _, ok := c.get("a"); !ok {
+		t.Error("a should still be present")
+	}
+	if _, _, ok := c.get("c"); !ok {
+		t.Error("c should still be present")
+	}
+	if entries := c.stats()["entries"]; entries != 2 {
+		t.Errorf("entries = %d, want 2", entries)
+	}
+}
@@ -0,0 +1,249 @@
+// Package nws implements provider.Provider against the National Weather
+// Service API, plus the hourly-forecast and active-alerts lookups that are
+// specific to NWS and have no equivalent shape in other providers.
+package nws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+)
+
+var (
+	pointsCacheTTL   = flag.Duration("nws-points-cache-ttl", 24*time.Hour, "TTL for cached NWS points (lat/lon -> forecast URL) lookups")
+	forecastCacheTTL = flag.Duration("nws-forecast-cache-ttl", 10*time.Minute, "TTL for cached NWS forecast responses")
+	cacheCapacity    = flag.Int("nws-cache-capacity", 512, "maximum entries retained per NWS cache before evicting the least recently used")
+)
+
+// Client is a provider.Provider backed by api.weather.gov, fronted by a
+// points cache (grid lookups rarely change) and a forecast cache (NWS
+// refreshes forecasts roughly hourly).
+type Client struct {
+	http   *http.Client
+	pCache *ttlCache[pointsInfo]
+	fCache *ttlCache[forecastResponse]
+}
+
+// New returns a ready-to-use Client. httpClient is shared with the rest of
+// the service so every upstream call honors the same timeout.
+func New(httpClient *http.Client) *Client {
+	return &Client{
+		http:   httpClient,
+		pCache: newTTLCache[pointsInfo](*pointsCacheTTL, *cacheCapacity),
+		fCache: newTTLCache[forecastResponse](*forecastCacheTTL, *cacheCapacity),
+	}
+}
+
+// CacheStats reports point-in-time hit/miss/stale counts for the points and
+// forecast caches, keyed by cache name.
+func (c *Client) CacheStats() map[string]map[string]int64 {
+	return map[string]map[string]int64{
+		"points":   c.pCache.stats(),
+		"forecast": c.fCache.stats(),
+	}
+}
+
+// Fetch implements provider.Provider: it returns the daily (day/night)
+// forecast periods for a point, converted into the requested units.
+func (c *Client) Fetch(ctx context.Context, lat, lon float64, units provider.Units, days int) (provider.Forecast, error) {
+	pts, err := c.getPoints(ctx, lat, lon)
+	if err != nil {
+		return provider.Forecast{}, err
+	}
+
+	fr, err := c.getForecastResponse(ctx, pts.Forecast)
+	if err != nil {
+		return provider.Forecast{}, err
+	}
+
+	periods := fr.Properties.Periods
+	if days > 0 {
+		// NWS alternates day/night periods, so each day spans two entries.
+		if max := days * 2; max < len(periods) {
+			periods = periods[:max]
+		}
+	}
+	return provider.Forecast{Periods: convertPeriods(periods, units)}, nil
+}
+
+// Hourly returns the hourly forecast periods for a point, converted into
+// the requested units. It has no equivalent in provider.Provider because
+// not every upstream exposes an hourly grid.
+func (c *Client) Hourly(ctx context.Context, lat, lon float64, units provider.Units) (provider.Forecast, error) {
+	pts, err := c.getPoints(ctx, lat, lon)
+	if err != nil {
+		return provider.Forecast{}, err
+	}
+	if pts.ForecastHourly == "" {
+		return provider.Forecast{}, ErrNoForecastForPoint
+	}
+
+	fr, err := c.getForecastResponse(ctx, pts.ForecastHourly)
+	if err != nil {
+		return provider.Forecast{}, err
+	}
+	return provider.Forecast{Periods: convertPeriods(fr.Properties.Periods, units)}, nil
+}
+
+// Alerts fetches the active NWS alerts covering a point.
+func (c *Client) Alerts(ctx context.Context, lat, lon float64) (AlertFeatureCollection, error) {
+	alertsURL := fmt.Sprintf("https://api.weather.gov/alerts/active?point=%.4f,%.4f", lat, lon)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, alertsURL, nil)
+	if err != nil {
+		return AlertFeatureCollection{}, err
+	}
+	req.Header.Set("User-Agent", "weather-service-example")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return AlertFeatureCollection{}, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var fc AlertFeatureCollection
+	if err := json.Unmarshal(body, &fc); err != nil {
+		return AlertFeatureCollection{}, err
+	}
+	return fc, nil
+}
+
+// ErrNoForecastForPoint is returned by Hourly when the NWS points response
+// for an otherwise-valid point has no hourly forecast URL. An empty list of
+// periods is returned as an empty Forecast, not as this error.
+var ErrNoForecastForPoint = errors.New("no forecast periods returned for point")
+
+// getPoints resolves the NWS grid endpoints for lat/lon, consulting pCache
+// first since a given point's grid forecast URLs almost never change.
+func (c *Client) getPoints(ctx context.Context, lat, lon float64) (pointsInfo, error) {
+	key := pointsCacheKey(lat, lon)
+	cached, fresh, ok := c.pCache.get(key)
+	if ok && fresh {
+		return cached, nil
+	}
+
+	pointsURL := fmt.Sprintf("https://api.weather.gov/points/%.4f,%.4f", lat, lon)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pointsURL, nil)
+	if err != nil {
+		return pointsInfo{}, err
+	}
+	req.Header.Set("User-Agent", "weather-service-example")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if ok {
+			c.pCache.markStaleServed()
+			return cached, nil
+		}
+		return pointsInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var pr pointsResponse
+	if err := json.Unmarshal(body, &pr); err != nil {
+		if ok {
+			c.pCache.markStaleServed()
+			return cached, nil
+		}
+		return pointsInfo{}, err
+	}
+
+	info := pointsInfo{Forecast: pr.Properties.Forecast, ForecastHourly: pr.Properties.ForecastHourly}
+	c.pCache.set(key, info)
+	return info, nil
+}
+
+// getForecastResponse fetches the NWS forecast at forecastURL, consulting
+// fCache first.
+func (c *Client) getForecastResponse(ctx context.Context, forecastURL string) (forecastResponse, error) {
+	cached, fresh, ok := c.fCache.get(forecastURL)
+	if ok && fresh {
+		return cached, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, forecastURL, nil)
+	if err != nil {
+		return forecastResponse{}, err
+	}
+	req.Header.Set("User-Agent", "weather-service-example")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if ok {
+			c.fCache.markStaleServed()
+			return cached, nil
+		}
+		return forecastResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var fr forecastResponse
+	if err := json.Unmarshal(body, &fr); err != nil {
+		if ok {
+			c.fCache.markStaleServed()
+			return cached, nil
+		}
+		return forecastResponse{}, err
+	}
+
+	c.fCache.set(forecastURL, fr)
+	return fr, nil
+}
+
+// convertPeriods converts NWS periods, which are always reported in
+// Fahrenheit, into the requested units.
+func convertPeriods(periods []forecastPeriod, units provider.Units) []provider.Period {
+	out := make([]provider.Period, 0, len(periods))
+	for _, p := range periods {
+		out = append(out, provider.Period{
+			Name:             p.Name,
+			StartTime:        p.StartTime,
+			Temperature:      convertFahrenheit(p.Temperature, units),
+			TemperatureUnit:  unitLabel(units),
+			TemperatureTrend: p.TemperatureTrend,
+			WindSpeed:        p.WindSpeed,
+			WindDirection:    p.WindDirection,
+			ShortForecast:    p.ShortForecast,
+			DetailedForecast: p.DetailedForecast,
+			IsDaytime:        p.IsDaytime,
+		})
+	}
+	return out
+}
+
+func convertFahrenheit(f int, units provider.Units) int {
+	switch units {
+	case provider.Metric:
+		return int(math.Round((float64(f) - 32) * 5 / 9))
+	case provider.Standard:
+		celsius := (float64(f) - 32) * 5 / 9
+		return int(math.Round(celsius + 273.15))
+	default:
+		return f
+	}
+}
+
+func unitLabel(units provider.Units) string {
+	switch units {
+	case provider.Metric:
+		return "C"
+	case provider.Standard:
+		return "K"
+	default:
+		return "F"
+	}
+}
+
+// pointsCacheKey buckets nearby coordinates together, since the NWS grid
+// point for a given location doesn't change at sub-hundredth-of-a-degree
+// resolution.
+func pointsCacheKey(lat, lon float64) string {
+	return fmt.Sprintf("%.2f,%.2f", lat, lon)
+}
@@ -0,0 +1,45 @@
+package nws
+
+import (
+	"testing"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+)
+
+func TestConvertFahrenheit(t *testing.T) {
+	cases := []struct {
+		name  string
+		f     int
+		units provider.Units
+		want  int
+	}{
+		{"imperial passthrough", 72, provider.Imperial, 72},
+		{"freezing to celsius", 32, provider.Metric, 0},
+		{"boiling to celsius", 212, provider.Metric, 100},
+		{"freezing to kelvin", 32, provider.Standard, 273},
+		{"boiling to kelvin", 212, provider.Standard, 373},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := convertFahrenheit(c.f, c.units); got != c.want {
+				t.Errorf("convertFahrenheit(%d, %v) = %d, want %d", c.f, c.units, got, c.want)
+			}
+		})
+	}
+}
+
+func TestUnitLabel(t *testing.T) {
+	cases := []struct {
+		units provider.Units
+		want  string
+	}{
+		{provider.Imperial, "F"},
+		{provider.Metric, "C"},
+		{provider.Standard, "K"},
+	}
+	for _, c := range cases {
+		if got := unitLabel(c.units); got != c.want {
+			t.Errorf("unitLabel(%v) = %q, want %q", c.units, got, c.want)
+		}
+	}
+}
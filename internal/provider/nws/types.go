@@ -0,0 +1,56 @@
+package nws
+
+// pointsResponse represents the response from the NWS points API.
+type pointsResponse struct {
+	Properties struct {
+		Forecast       string `json:"forecast"`
+		ForecastHourly string `json:"forecastHourly"`
+	} `json:"properties"`
+}
+
+// pointsInfo is the subset of pointsResponse the client caches.
+type pointsInfo struct {
+	Forecast       string
+	ForecastHourly string
+}
+
+// forecastPeriod is a single NWS forecast period, in its native
+// Fahrenheit. The same shape is returned by the daily and hourly
+// endpoints.
+type forecastPeriod struct {
+	Name             string `json:"name"`
+	StartTime        string `json:"startTime"`
+	Temperature      int    `json:"temperature"`
+	TemperatureUnit  string `json:"temperatureUnit"`
+	TemperatureTrend string `json:"temperatureTrend"`
+	WindSpeed        string `json:"windSpeed"`
+	WindDirection    string `json:"windDirection"`
+	ShortForecast    string `json:"shortForecast"`
+	DetailedForecast string `json:"detailedForecast"`
+	IsDaytime        bool   `json:"isDaytime"`
+}
+
+// forecastResponse represents the response from the NWS forecast API.
+type forecastResponse struct {
+	Properties struct {
+		Periods []forecastPeriod `json:"periods"`
+	} `json:"properties"`
+}
+
+// AlertFeatureCollection represents the response from the NWS active
+// alerts API (GeoJSON FeatureCollection, trimmed to the fields we surface).
+type AlertFeatureCollection struct {
+	Features []AlertFeature `json:"features"`
+}
+
+// AlertFeature is a single active alert.
+type AlertFeature struct {
+	Properties AlertProperties `json:"properties"`
+}
+
+// AlertProperties holds the alert fields callers care about.
+type AlertProperties struct {
+	Event       string `json:"event"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,113 @@
+// Package openweather implements provider.Provider against the
+// OpenWeatherMap 5-day/3-hour forecast API, for locations NWS doesn't cover.
+package openweather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
+)
+
+// periodsPerDay is how many 3-hour steps the forecast endpoint returns per
+// calendar day.
+const periodsPerDay = 8
+
+// Client is a provider.Provider backed by api.openweathermap.org.
+type Client struct {
+	apiKey string
+	http   *http.Client
+}
+
+// New returns a Client. apiKey is required; without one, Fetch fails
+// closed rather than silently falling back to another provider.
+func New(apiKey string, httpClient *http.Client) *Client {
+	return &Client{apiKey: apiKey, http: httpClient}
+}
+
+// Fetch implements provider.Provider.
+func (c *Client) Fetch(ctx context.Context, lat, lon float64, units provider.Units, days int) (provider.Forecast, error) {
+	if c.apiKey == "" {
+		return provider.Forecast{}, fmt.Errorf("OPENWEATHER_API_KEY not configured")
+	}
+
+	forecastURL := fmt.Sprintf(
+		"https://api.openweathermap.org/data/2.5/forecast?lat=%.4f&lon=%.4f&units=%s&appid=%s",
+		lat, lon, url.QueryEscape(string(units)), c.apiKey,
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, forecastURL, nil)
+	if err != nil {
+		return provider.Forecast{}, err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return provider.Forecast{}, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var owm struct {
+		List []struct {
+			DtTxt string `json:"dt_txt"`
+			Main  struct {
+				Temp float64 `json:"temp"`
+			} `json:"main"`
+			Weather []struct {
+				Description string `json:"description"`
+			} `json:"weather"`
+			Wind struct {
+				Speed float64 `json:"speed"`
+				Deg   float64 `json:"deg"`
+			} `json:"wind"`
+			Sys struct {
+				Pod string `json:"pod"` // "d" or "n"
+			} `json:"sys"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(body, &owm); err != nil {
+		return provider.Forecast{}, err
+	}
+
+	entries := owm.List
+	if days > 0 {
+		if max := days * periodsPerDay; max < len(entries) {
+			entries = entries[:max]
+		}
+	}
+
+	periods := make([]provider.Period, 0, len(entries))
+	for _, e := range entries {
+		desc := ""
+		if len(e.Weather) > 0 {
+			desc = e.Weather[0].Description
+		}
+		periods = append(periods, provider.Period{
+			Name:             e.DtTxt,
+			StartTime:        e.DtTxt,
+			Temperature:      int(math.Round(e.Main.Temp)),
+			TemperatureUnit:  unitLabel(units),
+			WindSpeed:        fmt.Sprintf("%.0f", e.Wind.Speed),
+			WindDirection:    fmt.Sprintf("%.0f", e.Wind.Deg),
+			ShortForecast:    desc,
+			DetailedForecast: desc,
+			IsDaytime:        e.Sys.Pod == "d",
+		})
+	}
+	return provider.Forecast{Periods: periods}, nil
+}
+
+func unitLabel(units provider.Units) string {
+	switch units {
+	case provider.Metric:
+		return "C"
+	case provider.Standard:
+		return "K"
+	default:
+		return "F"
+	}
+}
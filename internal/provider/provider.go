@@ -0,0 +1,64 @@
+// Package provider defines the interface forecast data sources implement,
+// so the rest of the service can be pointed at NWS, OpenWeatherMap, or
+// whatever comes next without changing its callers.
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// Units identifies the unit system forecast temperatures are expressed in.
+// The values match OpenWeatherMap's `units` query parameter, which the nws
+// provider also converts into.
+type Units string
+
+const (
+	Imperial Units = "imperial" // Fahrenheit
+	Metric   Units = "metric"   // Celsius
+	Standard Units = "standard" // Kelvin
+)
+
+// ParseUnits validates a units query parameter, defaulting to Imperial when
+// s is empty.
+func ParseUnits(s string) (Units, error) {
+	switch Units(s) {
+	case "", Imperial:
+		return Imperial, nil
+	case Metric:
+		return Metric, nil
+	case Standard:
+		return Standard, nil
+	default:
+		return "", fmt.Errorf("invalid units %q: must be imperial, metric, or standard", s)
+	}
+}
+
+// Period is one forecast period, independent of which upstream produced it.
+// Field tags preserve the wire format the /weather/hourly, /weather/extended,
+// and /weather/bundle endpoints have always returned.
+type Period struct {
+	Name             string `json:"name"`
+	StartTime        string `json:"startTime"`
+	Temperature      int    `json:"temperature"`
+	TemperatureUnit  string `json:"temperatureUnit"`
+	TemperatureTrend string `json:"temperatureTrend"`
+	WindSpeed        string `json:"windSpeed"`
+	WindDirection    string `json:"windDirection"`
+	ShortForecast    string `json:"shortForecast"`
+	DetailedForecast string `json:"detailedForecast"`
+	IsDaytime        bool   `json:"isDaytime"`
+}
+
+// Forecast is what a Provider returns for a location.
+type Forecast struct {
+	Periods []Period
+}
+
+// Provider fetches forecast periods for a coordinate from an upstream
+// weather API, converted into the requested unit system. days bounds how
+// many days of periods to return; 0 means the provider's own default
+// horizon.
+type Provider interface {
+	Fetch(ctx context.Context, lat, lon float64, units Units, days int) (Forecast, error)
+}
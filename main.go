@@ -1,38 +1,41 @@
 // Weather Service Assignment
-// Simple HTTP server in Go that returns today's short forecast and temperature classification
+// HTTP and gRPC server that returns today's short forecast and temperature
+// classification. Locations may be given as lat/lon, a city name, or a ZIP
+// code, and forecasts may come from any configured provider (see
+// internal/forecaster, internal/provider).
 
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/anthonygilbertt/go-weather-app/internal/forecaster"
+	"github.com/anthonygilbertt/go-weather-app/internal/grpcserver"
+	"github.com/anthonygilbertt/go-weather-app/internal/middleware"
+	"github.com/anthonygilbertt/go-weather-app/internal/provider"
 )
 
-// PointsResponse represents the response from the NWS points API
-type PointsResponse struct {
-	Properties struct {
-		Forecast string `json:"forecast"`
-	} `json:"properties"`
-}
+var (
+	grpcAddr = flag.String("grpc-addr", ":9090", "address the gRPC server listens on")
 
-// ForecastResponse represents the response from the NWS forecast API
-type ForecastResponse struct {
-	Properties struct {
-		Periods []struct {
-			Name            string `json:"name"`
-			StartTime       string `json:"startTime"`
-			Temperature     int    `json:"temperature"`
-			TemperatureUnit string `json:"temperatureUnit"`
-			ShortForecast   string `json:"shortForecast"`
-			IsDaytime       bool   `json:"isDaytime"`
-		} `json:"periods"`
-	} `json:"properties"`
-}
+	rateLimitRPM     = flag.Int("rate-limit-rpm", 120, "requests allowed per client IP per minute")
+	rateLimitBurst   = flag.Int("rate-limit-burst", 30, "burst allowance above the steady rate, in requests")
+	responseCacheTTL = flag.Duration("response-cache-ttl", 30*time.Second, "default TTL for cached route responses")
+	authEnabled      = flag.Bool("auth-enabled", false, "require a bearer token on every request")
+	authToken        = flag.String("auth-token", os.Getenv("WEATHER_AUTH_TOKEN"), "bearer token required when -auth-enabled is set")
+)
 
 // WeatherResult is the JSON structure returned by our endpoint
 type WeatherResult struct {
@@ -41,101 +44,246 @@ type WeatherResult struct {
 	Classification string `json:"classification"`
 }
 
+// fc is constructed in main(), after flag.Parse(), since forecaster.New()
+// reads the nws cache TTL/capacity flags. Building it at package-init time
+// would always see their declared default values regardless of what's passed
+// on the command line.
+var fc *forecaster.Forecaster
+
 func main() {
-	http.HandleFunc("/weather", weatherHandler)
-	log.Println("Starting server on :8080")
-	log.Fatal(http.ListenAndServe(":8080", nil))
+	flag.Parse()
+
+	fc = forecaster.New()
+
+	go serveGRPC()
+
+	metrics := middleware.NewMetrics()
+	rateLimiter := middleware.NewRateLimiter(middleware.RateLimiterConfig{
+		RPM:   *rateLimitRPM,
+		Burst: *rateLimitBurst,
+	}, metrics)
+	responseCache := middleware.NewResponseCache(512, *responseCacheTTL, map[string]time.Duration{
+		"/weather/alerts": 2 * time.Minute,
+	}, metrics)
+	auth := middleware.Auth(middleware.AuthConfig{Enabled: *authEnabled, Token: *authToken}, metrics)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/weather", weatherHandler)
+	mux.HandleFunc("/weather/hourly", hourlyHandler)
+	mux.HandleFunc("/weather/extended", extendedHandler)
+	mux.HandleFunc("/weather/alerts", alertsHandler)
+	mux.HandleFunc("/weather/bundle", bundleHandler)
+	mux.HandleFunc("/debug/cache", cacheStatsHandler)
+	mux.HandleFunc("/metrics", metrics.Handler())
+
+	chain := middleware.New(rateLimiter.Middleware, auth, responseCache.Middleware)
+
+	log.Println("Starting HTTP server on :8080")
+	log.Fatal(http.ListenAndServe(":8080", chain.Then(mux)))
+}
+
+// serveGRPC starts the WeatherService gRPC server alongside the HTTP server
+// so both speak to the same Forecaster instance, and therefore the same
+// caches.
+func serveGRPC() {
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *grpcAddr, err)
+	}
+
+	s := grpc.NewServer()
+	grpcserver.Register(s, fc)
+	log.Printf("Starting gRPC server on %s", *grpcAddr)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("grpc server stopped: %v", err)
+	}
+}
+
+// cacheStatsHandler reports hit/miss/stale counts for the nws provider's
+// points and forecast caches, useful for tuning its TTL flags.
+func cacheStatsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(fc.CacheStats())
 }
 
 func weatherHandler(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters
-	latStr := r.URL.Query().Get("lat")
-	lonStr := r.URL.Query().Get("lon")
-	if latStr == "" || lonStr == "" {
-		http.Error(w, "Missing lat or lon parameter", http.StatusBadRequest)
+	loc, units, providerName, err := resolveRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := fc.Get(r.Context(), loc, units, providerName)
+	if err != nil {
+		writeFetchError(w, err, "Failed to fetch forecast")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(WeatherResult{
+		Forecast:       result.ShortForecast,
+		Temperature:    result.Temperature,
+		Classification: result.Classification,
+	})
+}
+
+func hourlyHandler(w http.ResponseWriter, r *http.Request) {
+	loc, units, _, err := resolveRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	periods, err := fc.GetHourly(r.Context(), loc, units)
+	if err != nil {
+		log.Println("Error fetching hourly forecast:", err)
+		http.Error(w, "Failed to fetch hourly forecast", http.StatusInternalServerError)
 		return
 	}
-	lat, err := strconv.ParseFloat(latStr, 64)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"periods": periods})
+}
+
+func extendedHandler(w http.ResponseWriter, r *http.Request) {
+	loc, units, providerName, err := resolveRequest(r)
 	if err != nil {
-		http.Error(w, "Invalid latitude", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	lon, err := strconv.ParseFloat(lonStr, 64)
+
+	days, err := parseDays(r)
 	if err != nil {
-		http.Error(w, "Invalid longitude", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	forecast, temp, classification, err := getForecast(lat, lon)
+	periods, err := fc.GetExtended(r.Context(), loc, units, days, providerName)
 	if err != nil {
-		log.Println("Error fetching forecast:", err)
-		http.Error(w, "Failed to fetch forecast", http.StatusInternalServerError)
+		writeFetchError(w, err, "Failed to fetch extended forecast")
 		return
 	}
 
-	result := WeatherResult{
-		Forecast:       forecast,
-		Temperature:    temp,
-		Classification: classification,
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"periods": periods})
+}
+
+func alertsHandler(w http.ResponseWriter, r *http.Request) {
+	loc, _, _, err := resolveRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
+
+	alerts, err := fc.GetAlerts(r.Context(), loc)
+	if err != nil {
+		log.Println("Error fetching alerts:", err)
+		http.Error(w, "Failed to fetch alerts", http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(result)
+	json.NewEncoder(w).Encode(alerts)
 }
 
-func getForecast(lat, lon float64) (string, int, string, error) {
-	// Step 1: Call points API
-	pointsURL := fmt.Sprintf("https://api.weather.gov/points/%.4f,%.4f", lat, lon)
-	pointsReq, _ := http.NewRequest("GET", pointsURL, nil)
-	pointsReq.Header.Set("User-Agent", "weather-service-example")
-	resp, err := http.DefaultClient.Do(pointsReq)
+// bundleHandler fans out to the current, hourly, extended, and alerts
+// endpoints in parallel and returns them in a single payload.
+func bundleHandler(w http.ResponseWriter, r *http.Request) {
+	loc, units, providerName, err := resolveRequest(r)
 	if err != nil {
-		return "", 0, "", err
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	defer resp.Body.Close()
 
-	body, _ := ioutil.ReadAll(resp.Body)
-	var pr PointsResponse
-	if err := json.Unmarshal(body, &pr); err != nil {
-		return "", 0, "", err
+	days, err := parseDays(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	// Step 2: Call forecast API
-	forecastURL := pr.Properties.Forecast
-	foreReq, _ := http.NewRequest("GET", forecastURL, nil)
-	foreReq.Header.Set("User-Agent", "weather-service-example")
-	resp2, err := http.DefaultClient.Do(foreReq)
+	bundle, err := fc.GetBundle(r.Context(), loc, units, days, providerName)
 	if err != nil {
-		return "", 0, "", err
+		writeFetchError(w, err, "Failed to fetch weather bundle")
+		return
 	}
-	defer resp2.Body.Close()
 
-	body2, _ := ioutil.ReadAll(resp2.Body)
-	var fr ForecastResponse
-	if err := json.Unmarshal(body2, &fr); err != nil {
-		return "", 0, "", err
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(bundle)
+}
+
+// writeFetchError maps a forecast-fetch error to a response: an unknown
+// provider= is a client input error (400), same as a bad location, while
+// anything else is treated as an opaque upstream failure (500) logged under
+// msg.
+func writeFetchError(w http.ResponseWriter, err error, msg string) {
+	var upe *forecaster.UnknownProviderError
+	if errors.As(err, &upe) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
+	log.Println(msg+":", err)
+	http.Error(w, msg, http.StatusInternalServerError)
+}
 
-	// Step 3: Find today's daytime period
-	today := time.Now().Format("2006-01-02")
-	for _, p := range fr.Properties.Periods {
-		if p.IsDaytime && len(p.StartTime) >= 10 && p.StartTime[:10] == today {
-			return p.ShortForecast, p.Temperature, classify(p.Temperature), nil
-		}
+// parseDays reads the optional days query parameter used by the extended
+// and bundle endpoints, defaulting to 0 (the provider's full horizon).
+func parseDays(r *http.Request) (int, error) {
+	daysStr := r.URL.Query().Get("days")
+	if daysStr == "" {
+		return 0, nil
+	}
+	days, err := strconv.Atoi(daysStr)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("invalid days parameter")
+	}
+	return days, nil
+}
+
+// resolveRequest resolves the location, unit system, and provider selection
+// common to every /weather endpoint.
+func resolveRequest(r *http.Request) (forecaster.LatLon, provider.Units, string, error) {
+	units, err := provider.ParseUnits(r.URL.Query().Get("units"))
+	if err != nil {
+		return forecaster.LatLon{}, "", "", err
+	}
+
+	loc, err := resolveLocation(r.Context(), r)
+	if err != nil {
+		return forecaster.LatLon{}, "", "", err
 	}
 
-	// Fallback to first period
-	p := fr.Properties.Periods[0]
-	return p.ShortForecast, p.Temperature, classify(p.Temperature), nil
+	return loc, units, r.URL.Query().Get("provider"), nil
 }
 
-func classify(temp int) string {
+// resolveLocation inspects the query parameters and resolves a LatLon from
+// whichever location form the caller supplied: explicit lat/lon, a city
+// name, or a ZIP code.
+func resolveLocation(ctx context.Context, r *http.Request) (forecaster.LatLon, error) {
+	q := r.URL.Query()
+	latStr, lonStr := q.Get("lat"), q.Get("lon")
+	city := q.Get("city")
+	zip := q.Get("zip")
+
 	switch {
-	case temp >= 80:
-		return "hot"
-	case temp <= 50:
-		return "cold"
+	case latStr != "" || lonStr != "":
+		if latStr == "" || lonStr == "" {
+			return forecaster.LatLon{}, fmt.Errorf("missing lat or lon parameter")
+		}
+		lat, err := strconv.ParseFloat(latStr, 64)
+		if err != nil {
+			return forecaster.LatLon{}, fmt.Errorf("invalid latitude")
+		}
+		lon, err := strconv.ParseFloat(lonStr, 64)
+		if err != nil {
+			return forecaster.LatLon{}, fmt.Errorf("invalid longitude")
+		}
+		return forecaster.LatLon{Lat: lat, Lon: lon}, nil
+	case zip != "":
+		return fc.ResolveLocation(ctx, zip, forecaster.LocationZip)
+	case city != "":
+		return fc.ResolveLocation(ctx, city, forecaster.LocationCity)
 	default:
-		return "moderate"
+		return forecaster.LatLon{}, fmt.Errorf("missing lat/lon, city, or zip parameter")
 	}
 }
 
@@ -144,6 +292,10 @@ Build & Run Instructions:
 
 1. Run: go build -o weather-service
 2. Start: ./weather-service
-3. Query: http://localhost:8080/weather?lat=38.8977&lon=-77.0365
+3. Query HTTP: http://localhost:8080/weather?lat=38.8977&lon=-77.0365&units=metric
+4. Query gRPC (the server does not register reflection, so pass the proto):
+   grpcurl -plaintext -import-path proto -proto weather.proto \
+     -d '{"location":{"coordinates":{"lat":38.8977,"lon":-77.0365}}}' \
+     localhost:9090 weather.WeatherService/GetCurrent
 
 */